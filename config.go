@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// Template describes a project scaffold: where to clone it from, what to
+// run immediately after cloning, and how to patch its package.json.
+type Template struct {
+	RepoURL   string   `mapstructure:"repo_url" yaml:"repo_url"`
+	Branch    string   `mapstructure:"branch" yaml:"branch"`
+	PostClone []string `mapstructure:"post_clone" yaml:"post_clone"`
+	// PackageJSONPatch is a "dotted.path=value" expression (e.g.
+	// "scripts.predev=echo ready") applied to package.json via sjson
+	// during createProject.
+	PackageJSONPatch string `mapstructure:"package_json_patch" yaml:"package_json_patch"`
+}
+
+// Config is the resolved myapp-cli configuration, layered from (in order of
+// increasing precedence) built-in defaults, the config file, and
+// environment variables.
+type Config struct {
+	ProjectsDir     string              `mapstructure:"projects_dir" yaml:"projects_dir"`
+	WebappsDir      string              `mapstructure:"webapps_dir" yaml:"webapps_dir"`
+	DefaultTemplate string              `mapstructure:"default_template" yaml:"default_template"`
+	Templates       map[string]Template `mapstructure:"templates" yaml:"templates"`
+}
+
+const defaultTemplateName = "default"
+
+func defaultConfig() Config {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+
+	return Config{
+		ProjectsDir:     filepath.Join(homeDir, "NextJS-Projects"),
+		WebappsDir:      "webapps",
+		DefaultTemplate: defaultTemplateName,
+		Templates: map[string]Template{
+			defaultTemplateName: {
+				RepoURL: "https://github.com/AndrewDonelson/my-app",
+			},
+		},
+	}
+}
+
+// configDir returns the per-OS directory myapp-cli stores its config in:
+// $XDG_CONFIG_HOME/myapp-cli on Linux/macOS, %APPDATA%\myapp-cli on Windows.
+func configDir() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine config directory: %w", err)
+	}
+	return filepath.Join(base, "myapp-cli"), nil
+}
+
+func configFilePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// loadConfig builds a Config from defaults, the config file (if present),
+// and environment variables prefixed MYAPP_CLI_.
+func loadConfig() (*Config, error) {
+	def := defaultConfig()
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetEnvPrefix("myapp_cli")
+	v.AutomaticEnv()
+
+	v.SetDefault("projects_dir", def.ProjectsDir)
+	v.SetDefault("webapps_dir", def.WebappsDir)
+	v.SetDefault("default_template", def.DefaultTemplate)
+	v.SetDefault("templates", def.Templates)
+
+	path, err := configFilePath()
+	if err != nil {
+		return nil, err
+	}
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+	}
+
+	var c Config
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	if len(c.Templates) == 0 {
+		c.Templates = def.Templates
+	}
+	if c.DefaultTemplate == "" {
+		c.DefaultTemplate = def.DefaultTemplate
+	}
+
+	return &c, nil
+}
+
+// Template looks up a named template, falling back to DefaultTemplate.
+func (c *Config) Template(name string) (Template, error) {
+	if name == "" {
+		name = c.DefaultTemplate
+	}
+	tmpl, ok := c.Templates[name]
+	if !ok {
+		return Template{}, fmt.Errorf("no template named %q configured (known templates: %v)", name, templateNames(c.Templates))
+	}
+	return tmpl, nil
+}
+
+func templateNames(templates map[string]Template) []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	return names
+}
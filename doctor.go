@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func checkPrerequisites(cr *CommandRunner) error {
+	// Project creation itself is handled in-process via go-git, but `list`
+	// still shells out to the real git binary for per-project status, so it
+	// remains a required prerequisite.
+	if err := cr.execCommand("git", "--version"); err != nil {
+		return fmt.Errorf("git is not installed or not in PATH")
+	}
+
+	if err := cr.execCommand(cr.getNPMCommand(), "--version"); err != nil {
+		return fmt.Errorf("npm is not installed or not in PATH")
+	}
+
+	return nil
+}
+
+func doctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that git and npm are installed and reachable",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cr := NewCommandRunner()
+
+			fmt.Println("Checking prerequisites...")
+
+			checks := []struct {
+				name      string
+				check     func() error
+				remediate string
+			}{
+				{"git", func() error { return cr.execCommand("git", "--version") }, "install git from https://git-scm.com/downloads and ensure it is on your PATH (needed by `myapp-cli list` for per-project status)"},
+				{"npm", func() error { return cr.execCommand(cr.getNPMCommand(), "--version") }, "install Node.js from https://nodejs.org/ (npm ships with it)"},
+			}
+
+			failed := false
+			for _, c := range checks {
+				if err := c.check(); err != nil {
+					failed = true
+					fmt.Printf("  [FAIL] %s: %v\n", c.name, err)
+					fmt.Printf("         fix: %s\n", c.remediate)
+					continue
+				}
+				fmt.Printf("  [ OK ] %s\n", c.name)
+			}
+
+			if failed {
+				return fmt.Errorf("one or more prerequisites are missing")
+			}
+
+			fmt.Println("\nAll prerequisites satisfied.")
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gogitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// templateAuth resolves credentials for cloning a (possibly private)
+// template repository: a GITHUB_TOKEN env var takes precedence over any
+// matching entry in the user's ~/.netrc. Returns nil auth for public repos.
+func templateAuth(repoURL string) (*http.BasicAuth, error) {
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		return &http.BasicAuth{Username: "git", Password: token}, nil
+	}
+
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, nil
+	}
+
+	login, password, ok := netrcCredentials(u.Hostname())
+	if !ok {
+		return nil, nil
+	}
+
+	return &http.BasicAuth{Username: login, Password: password}, nil
+}
+
+// netrcCredentials looks up a login/password pair for host in ~/.netrc.
+func netrcCredentials(host string) (login, password string, ok bool) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", "", false
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".netrc"))
+	if err != nil {
+		return "", "", false
+	}
+
+	fields := strings.Fields(string(data))
+	var machine string
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case "machine":
+			if i+1 < len(fields) {
+				machine = fields[i+1]
+				login, password = "", ""
+			}
+		case "login":
+			if i+1 < len(fields) {
+				login = fields[i+1]
+			}
+		case "password":
+			if i+1 < len(fields) {
+				password = fields[i+1]
+			}
+		}
+		if machine == host && login != "" && password != "" {
+			return login, password, true
+		}
+	}
+
+	return "", "", false
+}
+
+// cloneTemplate clones tmpl's repository into destPath using go-git,
+// in-process, instead of shelling out to git or gh.
+func cloneTemplate(tmpl Template, destPath string) error {
+	auth, err := templateAuth(tmpl.RepoURL)
+	if err != nil {
+		return fmt.Errorf("failed to resolve template credentials: %w", err)
+	}
+
+	opts := &git.CloneOptions{
+		URL:      tmpl.RepoURL,
+		Auth:     auth,
+		Progress: os.Stdout,
+	}
+	if tmpl.Branch != "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(tmpl.Branch)
+	}
+
+	if _, err := git.PlainClone(destPath, false, opts); err != nil {
+		return fmt.Errorf("failed to clone %s: %w", tmpl.RepoURL, err)
+	}
+
+	return nil
+}
+
+// reinitRepo strips the cloned template's git history and creates a fresh
+// repository with a single "Initial commit", replacing what used to be a
+// `git init && git add . && git commit` subprocess sequence.
+func reinitRepo(path string) error {
+	if err := os.RemoveAll(filepath.Join(path, ".git")); err != nil {
+		return fmt.Errorf("failed to remove existing .git directory: %w", err)
+	}
+
+	// Keep myapp-cli's own transcript directory (created once the commit
+	// below lands and a real command runs against the project) out of the
+	// user's repo, since create.log dumps the process environment.
+	if err := ignoreTranscriptDir(path); err != nil {
+		return err
+	}
+
+	repo, err := git.PlainInit(path, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize git repository: %w", err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	// go-git's plain Worktree.Add never consults .gitignore; only
+	// AddWithOptions{All: true} does, and only via the Excludes patterns we
+	// load here, so the .gitignore entry above is actually honored when
+	// staging the Initial commit.
+	patterns, err := gitignore.ReadPatterns(worktree.Filesystem, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+	worktree.Excludes = patterns
+
+	if err := worktree.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return fmt.Errorf("failed to stage files: %w", err)
+	}
+
+	sig, err := commitSignature()
+	if err != nil {
+		return fmt.Errorf("failed to determine commit author: %w", err)
+	}
+
+	if _, err := worktree.Commit("Initial commit", &git.CommitOptions{Author: sig}); err != nil {
+		return fmt.Errorf("failed to create initial commit: %w", err)
+	}
+
+	return nil
+}
+
+// ignoreTranscriptDir appends a .gitignore entry for transcriptDirName to
+// path, creating the file if necessary, so the Initial commit never stages
+// the project's create.log.
+func ignoreTranscriptDir(path string) error {
+	gitignorePath := filepath.Join(path, ".gitignore")
+
+	existing, err := os.ReadFile(gitignorePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	entry := transcriptDirName + "/"
+	for _, line := range strings.Split(string(existing), "\n") {
+		if strings.TrimSpace(line) == entry {
+			return nil
+		}
+	}
+
+	content := string(existing)
+	if len(content) > 0 && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	content += entry + "\n"
+
+	if err := os.WriteFile(gitignorePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write .gitignore: %w", err)
+	}
+
+	return nil
+}
+
+// commitSignature builds a commit signature from the user's global git
+// config, falling back to a generic myapp-cli identity if none is set.
+func commitSignature() (*object.Signature, error) {
+	gc, err := gogitconfig.LoadConfig(gogitconfig.GlobalScope)
+	if err == nil && gc.User.Name != "" {
+		return &object.Signature{
+			Name:  gc.User.Name,
+			Email: gc.User.Email,
+			When:  time.Now(),
+		}, nil
+	}
+
+	return &object.Signature{
+		Name:  "myapp-cli",
+		Email: "myapp-cli@localhost",
+		When:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func initCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generate a default myapp-cli config.yaml interactively",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := configFilePath()
+			if err != nil {
+				return err
+			}
+
+			reader := bufio.NewReader(os.Stdin)
+
+			if _, err := os.Stat(path); err == nil {
+				fmt.Printf("Config already exists at %s. Overwrite? [y/N]: ", path)
+				answer, _ := reader.ReadString('\n')
+				if strings.ToLower(strings.TrimSpace(answer)) != "y" {
+					fmt.Println("Aborted.")
+					return nil
+				}
+			}
+
+			def := defaultConfig()
+
+			fmt.Printf("Projects directory (default: %s): ", def.ProjectsDir)
+			if line, _ := reader.ReadString('\n'); strings.TrimSpace(line) != "" {
+				def.ProjectsDir = strings.TrimSpace(line)
+			}
+
+			defaultTemplate := def.Templates[defaultTemplateName]
+			fmt.Printf("Default template repo URL (default: %s): ", defaultTemplate.RepoURL)
+			if line, _ := reader.ReadString('\n'); strings.TrimSpace(line) != "" {
+				defaultTemplate.RepoURL = strings.TrimSpace(line)
+				def.Templates[defaultTemplateName] = defaultTemplate
+			}
+
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return fmt.Errorf("failed to create config directory: %w", err)
+			}
+
+			out, err := yaml.Marshal(def)
+			if err != nil {
+				return fmt.Errorf("failed to encode config: %w", err)
+			}
+
+			if err := os.WriteFile(path, out, 0644); err != nil {
+				return fmt.Errorf("failed to write config file: %w", err)
+			}
+
+			fmt.Printf("Wrote config to %s\n", path)
+			return nil
+		},
+	}
+
+	return cmd
+}
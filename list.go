@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type projectStatus struct {
+	Name        string
+	RemoteURL   string
+	Branch      string
+	LastCommit  string
+	HasNodeMods bool
+}
+
+// gitOutput runs `git -C dir <args>` and returns its trimmed stdout.
+func gitOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func inspectProject(dir string) projectStatus {
+	status := projectStatus{Name: filepath.Base(dir)}
+
+	if remote, err := gitOutput(dir, "remote", "get-url", "origin"); err == nil {
+		status.RemoteURL = remote
+	} else {
+		status.RemoteURL = "(no remote)"
+	}
+
+	if branch, err := gitOutput(dir, "rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		status.Branch = branch
+	} else {
+		status.Branch = "(unknown)"
+	}
+
+	if commitDate, err := gitOutput(dir, "log", "-1", "--format=%cI"); err == nil {
+		status.LastCommit = commitDate
+	} else {
+		status.LastCommit = "(no commits)"
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, "node_modules")); err == nil && info.IsDir() {
+		status.HasNodeMods = true
+	}
+
+	return status
+}
+
+func listCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List existing projects and their git/npm status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fullWebappsPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir)
+
+			entries, err := os.ReadDir(fullWebappsPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					fmt.Println("No projects found.")
+					return nil
+				}
+				return fmt.Errorf("failed to read webapps directory: %w", err)
+			}
+
+			found := false
+			for _, entry := range entries {
+				if !entry.IsDir() {
+					continue
+				}
+				found = true
+				status := inspectProject(filepath.Join(fullWebappsPath, entry.Name()))
+
+				nodeModules := "missing"
+				if status.HasNodeMods {
+					nodeModules = "installed"
+				}
+
+				fmt.Printf("%s\n", status.Name)
+				fmt.Printf("  remote:       %s\n", status.RemoteURL)
+				fmt.Printf("  branch:       %s\n", status.Branch)
+				fmt.Printf("  last commit:  %s\n", status.LastCommit)
+				fmt.Printf("  node_modules: %s\n\n", nodeModules)
+			}
+
+			if !found {
+				fmt.Println("No projects found.")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
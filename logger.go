@@ -0,0 +1,11 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+)
+
+// logger emits human-readable progress output to the terminal. Commands run
+// through CommandRunner.execCommand are additionally recorded as JSON lines
+// to the active project's transcript (see transcript.go).
+var logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func isProjectExists(name string) bool {
+	projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, name)
+	_, err := os.Stat(projectPath)
+	return !os.IsNotExist(err)
+}
+
+func promptProjectName() string {
+	reader := bufio.NewReader(os.Stdin)
+
+	for {
+		fmt.Printf("Enter the name for your new WebApp (default: %s): ", defaultProjectName)
+		name, err := reader.ReadString('\n')
+		if err != nil {
+			return defaultProjectName
+		}
+
+		name = strings.TrimSpace(name)
+		if name == "" {
+			name = defaultProjectName
+		}
+
+		if isProjectExists(name) {
+			fmt.Printf("\nA project with the name '%s' already exists. Please choose a different name.\n\n", name)
+			continue
+		}
+
+		// Basic name validation
+		if strings.ContainsAny(name, "\\/:*?\"<>|") {
+			fmt.Println("\nProject name contains invalid characters. Please use only letters, numbers, dashes, and underscores.")
+			continue
+		}
+
+		return name
+	}
+}
+
+func createProject(cr *CommandRunner, projectName string, tmpl Template, skipPredev bool) error {
+	if err := ensureWebappsDir(); err != nil {
+		return err
+	}
+
+	// Get absolute path for the project within webapps directory
+	fullWebappsPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir)
+	projectPath := filepath.Join(fullWebappsPath, projectName)
+
+	// Already checked by the caller, but double-check
+	if isProjectExists(projectName) {
+		return fmt.Errorf("directory %s already exists", projectPath)
+	}
+
+	logger.Info("creating webapp", "name", projectName, "template", tmpl.RepoURL)
+
+	// Clone the template repository in-process
+	if err := cloneTemplate(tmpl, projectPath); err != nil {
+		return err
+	}
+
+	// Change to project directory
+	if err := os.Chdir(projectPath); err != nil {
+		return fmt.Errorf("failed to change to project directory: %v", err)
+	}
+
+	// Strip the template's history and create a fresh "Initial commit"
+	logger.Info("initializing git repository")
+	if err := reinitRepo(projectPath); err != nil {
+		return err
+	}
+
+	// Record every command run from here on into the project's transcript.
+	// Attached after reinitRepo so create.log never gets staged into the
+	// Initial commit.
+	if err := cr.attachTranscript(projectPath); err != nil {
+		return err
+	}
+	defer cr.transcript.Close()
+
+	// Clean install node_modules
+	logger.Info("cleaning existing node_modules")
+	if err := os.RemoveAll("node_modules"); err != nil {
+		return fmt.Errorf("failed to remove node_modules: %v", err)
+	}
+
+	// Clean package-lock.json
+	if err := os.Remove("package-lock.json"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove package-lock.json: %v", err)
+	}
+
+	// Install dependencies
+	logger.Info("installing dependencies")
+	if err := cr.execCommand(cr.getNPMCommand(), "install"); err != nil {
+		return fmt.Errorf("failed to install dependencies: %v", err)
+	}
+
+	// Run any template-defined post-clone commands
+	for _, step := range tmpl.PostClone {
+		logger.Info("running post-clone step", "step", step)
+		if err := cr.execCommand("sh", "-c", step); err != nil {
+			return fmt.Errorf("post-clone step %q failed: %v", step, err)
+		}
+	}
+
+	// Apply the template's own package.json patch, if configured, before
+	// the skip-predev patch below so a backup/restore round-trip preserves
+	// the template's intended package.json rather than the upstream one.
+	if tmpl.PackageJSONPatch != "" {
+		logger.Info("applying template package_json_patch", "patch", tmpl.PackageJSONPatch)
+		if err := applyPackageJSONPatch(projectPath, tmpl.PackageJSONPatch); err != nil {
+			return err
+		}
+	}
+
+	// Patch package.json to skip predev for the initial run, unless the
+	// caller asked to leave it untouched.
+	if skipPredev {
+		logger.Info("adjusting package.json for initial setup")
+		if err := backupPackageJSON(projectPath); err != nil {
+			return err
+		}
+		if err := setPackageJSONScript(projectPath, "predev", "echo Skipping predev script for initial setup"); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("project setup completed successfully", "path", projectPath)
+	return nil
+}
+
+func newCmd() *cobra.Command {
+	var templateName string
+	var skipPredev bool
+
+	cmd := &cobra.Command{
+		Use:   "new [name]",
+		Short: "Scaffold a new webapp project from a configured template",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cr := NewCommandRunner()
+
+			if err := checkPrerequisites(cr); err != nil {
+				return fmt.Errorf("prerequisite check failed: %w", err)
+			}
+
+			if err := ensureWebappsDir(); err != nil {
+				return err
+			}
+
+			tmpl, err := cfg.Template(templateName)
+			if err != nil {
+				return err
+			}
+
+			var projectName string
+			if len(args) == 1 {
+				projectName = args[0]
+				if isProjectExists(projectName) {
+					return fmt.Errorf("a project named %q already exists", projectName)
+				}
+			} else {
+				projectName = promptProjectName()
+			}
+
+			if err := createProject(cr, projectName, tmpl, skipPredev); err != nil {
+				return fmt.Errorf("failed to create project: %w", err)
+			}
+
+			projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, projectName)
+			fmt.Println("\nTo continue setup, run these commands:")
+			fmt.Printf("\n   cd %s\n", projectPath)
+			if skipPredev {
+				fmt.Printf("   myapp-cli restore %s\n", projectName)
+			} else {
+				fmt.Println("   npm run dev")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&templateName, "template", "", "name of the configured template to scaffold from (default: the config's default_template)")
+	cmd.Flags().BoolVar(&skipPredev, "skip-predev", true, "patch package.json to skip the predev script for the first run (use --skip-predev=false to leave it untouched)")
+
+	return cmd
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tidwall/sjson"
+)
+
+const packageJSONBackupName = "package.json.backup"
+
+// reformatPackageJSON re-indents JSON bytes with the two-space style
+// package.json conventionally uses. sjson's SetBytes inserts new keys with
+// minimal, inconsistent whitespace, so every write goes through this before
+// hitting disk to keep the file's formatting stable.
+func reformatPackageJSON(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to format package.json: %w", err)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// setPackageJSONScript patches a single scripts.<key> entry in package.json
+// in place. Using sjson edits the JSON tree directly rather than matching
+// the exact literal script string, so it keeps working if the template's
+// predev script is reordered, reformatted, or edited by the user.
+func setPackageJSONScript(projectPath, key, value string) error {
+	path := filepath.Join(projectPath, "package.json")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	patched, err := sjson.SetBytes(data, "scripts."+key, value)
+	if err != nil {
+		return fmt.Errorf("failed to patch package.json scripts.%s: %w", key, err)
+	}
+
+	patched, err = reformatPackageJSON(patched)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, patched, 0644); err != nil {
+		return fmt.Errorf("failed to write package.json: %w", err)
+	}
+
+	return nil
+}
+
+// applyPackageJSONPatch applies a template's PackageJSONPatch expression,
+// a "dotted.path=value" pair (e.g. "scripts.predev=echo ready"), to
+// package.json via the same sjson tree-edit used by setPackageJSONScript.
+func applyPackageJSONPatch(projectPath, patch string) error {
+	path, value, found := strings.Cut(patch, "=")
+	if !found {
+		return fmt.Errorf("invalid package_json_patch %q: expected a \"path=value\" expression", patch)
+	}
+
+	data, err := os.ReadFile(filepath.Join(projectPath, "package.json"))
+	if err != nil {
+		return fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	patched, err := sjson.SetBytes(data, path, value)
+	if err != nil {
+		return fmt.Errorf("failed to apply package_json_patch %q: %w", patch, err)
+	}
+
+	patched, err = reformatPackageJSON(patched)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(projectPath, "package.json"), patched, 0644); err != nil {
+		return fmt.Errorf("failed to write package.json: %w", err)
+	}
+
+	return nil
+}
+
+// backupPackageJSON copies package.json to package.json.backup before it is
+// patched, so restoreCmd can put the original back later.
+func backupPackageJSON(projectPath string) error {
+	path := filepath.Join(projectPath, "package.json")
+	backupPath := filepath.Join(projectPath, packageJSONBackupName)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	if err := os.WriteFile(backupPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to create package.json backup: %w", err)
+	}
+
+	return nil
+}
+
+// restorePackageJSON swaps package.json.backup back over package.json,
+// undoing the predev patch applied during project creation.
+func restorePackageJSON(projectPath string) error {
+	backupPath := filepath.Join(projectPath, packageJSONBackupName)
+	path := filepath.Join(projectPath, "package.json")
+
+	data, err := os.ReadFile(backupPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", packageJSONBackupName, err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to restore package.json: %w", err)
+	}
+
+	if err := os.Remove(backupPath); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", packageJSONBackupName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+//go:build windows
+
+package main
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// startInNewProcessGroup configures cmd to run in its own console process
+// group so it and any children it spawns can be killed together.
+func startInNewProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// killProcessGroup terminates cmd's whole process tree via taskkill, since
+// Windows has no direct equivalent of a POSIX process-group signal.
+func killProcessGroup(cmd *exec.Cmd) error {
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	kill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid))
+	return kill.Run()
+}
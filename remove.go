@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func removeCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Delete a project directory from the webapps folder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, name)
+
+			if !isProjectExists(name) {
+				return fmt.Errorf("no project named %q exists", name)
+			}
+
+			if !force {
+				reader := bufio.NewReader(os.Stdin)
+				fmt.Printf("Remove %s? This cannot be undone. [y/N]: ", projectPath)
+				answer, _ := reader.ReadString('\n')
+				if strings.ToLower(strings.TrimSpace(answer)) != "y" {
+					fmt.Println("Aborted.")
+					return nil
+				}
+			}
+
+			if err := os.RemoveAll(projectPath); err != nil {
+				return fmt.Errorf("failed to remove %s: %w", projectPath, err)
+			}
+
+			fmt.Printf("Removed %s\n", projectPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "skip the confirmation prompt")
+
+	return cmd
+}
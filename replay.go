@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+func replayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <project>",
+		Short: "Re-run the setup commands recorded in a project's transcript, skipping dev servers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, name)
+
+			if !isProjectExists(name) {
+				return fmt.Errorf("no project named %q exists", name)
+			}
+
+			entries, err := readTranscript(projectPath)
+			if err != nil {
+				return fmt.Errorf("failed to read transcript for %q: %w", name, err)
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("no recorded commands found for %q", name)
+			}
+
+			for i, entry := range entries {
+				if len(entry.Command) == 0 {
+					continue
+				}
+
+				if isLongRunningCommand(entry.Command) {
+					logger.Info("skipping long-running command", "step", i+1, "total", len(entries), "command", entry.Command)
+					continue
+				}
+
+				logger.Info("replaying command", "step", i+1, "total", len(entries), "command", entry.Command)
+
+				replayed := exec.Command(entry.Command[0], entry.Command[1:]...)
+				replayed.Dir = entry.Dir
+				replayed.Stdout = os.Stdout
+				replayed.Stderr = os.Stderr
+
+				if err := replayed.Run(); err != nil {
+					return fmt.Errorf("replay failed at step %d (%v): %w", i+1, entry.Command, err)
+				}
+			}
+
+			fmt.Println("Replay completed successfully.")
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+func restoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <project>",
+		Short: "Restore the original package.json, then run npm run dev",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, name)
+
+			if !isProjectExists(name) {
+				return fmt.Errorf("no project named %q exists", name)
+			}
+
+			oldWD, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to determine current directory: %w", err)
+			}
+			defer os.Chdir(oldWD)
+
+			if err := os.Chdir(projectPath); err != nil {
+				return fmt.Errorf("failed to change to project directory: %w", err)
+			}
+
+			if err := restorePackageJSON(projectPath); err != nil {
+				return err
+			}
+			fmt.Println("Restored the original package.json.")
+
+			cr := NewCommandRunner()
+			if err := cr.attachTranscript(projectPath); err != nil {
+				return err
+			}
+			defer cr.transcript.Close()
+
+			fmt.Println("Running npm run dev...")
+			if err := cr.execCommand(cr.getNPMCommand(), "run", "dev"); err != nil {
+				return fmt.Errorf("npm run dev exited with an error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
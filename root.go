@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Version will be set at build time
+var version string
+
+const (
+	// Company Information
+	CompanyName = "Nlaak Studios"
+	WebsiteURL  = "https://nlaak.com"
+
+	defaultProjectName = "my-new-app"
+)
+
+// cfg holds the resolved configuration for the current invocation, loaded
+// once in rootCmd's PersistentPreRunE before any subcommand runs.
+var cfg *Config
+
+type CommandRunner struct {
+	isWindows  bool
+	transcript *Transcript
+}
+
+func NewCommandRunner() *CommandRunner {
+	return &CommandRunner{
+		isWindows: runtime.GOOS == "windows",
+	}
+}
+
+// attachTranscript directs subsequent execCommand calls to additionally
+// record a JSON-lines entry to projectPath's transcript.
+func (cr *CommandRunner) attachTranscript(projectPath string) error {
+	t, err := newTranscript(projectPath)
+	if err != nil {
+		return err
+	}
+	cr.transcript = t
+	return nil
+}
+
+func (cr *CommandRunner) execCommand(name string, args ...string) error {
+	start := time.Now()
+
+	cmd := exec.Command(name, args...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = io.MultiWriter(os.Stdout, &stdout)
+	cmd.Stderr = io.MultiWriter(os.Stderr, &stderr)
+
+	runErr := cmd.Run()
+	duration := time.Since(start)
+
+	exitCode := 0
+	if runErr != nil {
+		exitCode = -1
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			exitCode = exitErr.ExitCode()
+		}
+	}
+
+	dir := cmd.Dir
+	if dir == "" {
+		if wd, err := os.Getwd(); err == nil {
+			dir = wd
+		}
+	}
+
+	logger.Info("ran command", "command", append([]string{name}, args...), "exit_code", exitCode, "duration_ms", duration.Milliseconds())
+
+	if cr.transcript != nil {
+		cr.transcript.record(transcriptEntry{
+			Time:       start,
+			Dir:        dir,
+			Command:    append([]string{name}, args...),
+			Env:        redactEnv(os.Environ()),
+			DurationMS: duration.Milliseconds(),
+			ExitCode:   exitCode,
+			Stdout:     stdout.String(),
+			Stderr:     stderr.String(),
+		})
+	}
+
+	return runErr
+}
+
+// startCommand launches a long-running command in its own process group,
+// streaming its stdout/stderr to the terminal with the given prefix while
+// still recording a transcript entry (if attached) once it exits. Unlike
+// execCommand it does not block; the returned channel receives the
+// process's exit error when it finishes.
+func (cr *CommandRunner) startCommand(dir, prefix, name string, args ...string) (*exec.Cmd, <-chan error, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	startInNewProcessGroup(cmd)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to attach stdout: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to attach stderr: %w", err)
+	}
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start %s: %w", name, err)
+	}
+
+	go streamPrefixed(os.Stdout, prefix, stdout)
+	go streamPrefixed(os.Stderr, prefix, stderr)
+
+	logger.Info("started command", "command", append([]string{name}, args...), "dir", dir)
+
+	done := make(chan error, 1)
+	go func() {
+		runErr := cmd.Wait()
+		duration := time.Since(start)
+
+		exitCode := 0
+		if runErr != nil {
+			exitCode = -1
+			var exitErr *exec.ExitError
+			if errors.As(runErr, &exitErr) {
+				exitCode = exitErr.ExitCode()
+			}
+		}
+
+		logger.Info("command exited", "command", append([]string{name}, args...), "exit_code", exitCode, "duration_ms", duration.Milliseconds())
+
+		if cr.transcript != nil {
+			cr.transcript.record(transcriptEntry{
+				Time:       start,
+				Dir:        dir,
+				Command:    append([]string{name}, args...),
+				Env:        redactEnv(os.Environ()),
+				DurationMS: duration.Milliseconds(),
+				ExitCode:   exitCode,
+			})
+		}
+
+		done <- runErr
+	}()
+
+	return cmd, done, nil
+}
+
+func (cr *CommandRunner) getNPMCommand() string {
+	if cr.isWindows {
+		return "npm.cmd"
+	}
+	return "npm"
+}
+
+// isLongRunningCommand reports whether cmd starts a process that only exits
+// on shutdown (a dev server) rather than completing on its own, so replay
+// can skip re-running it instead of hanging forever.
+func isLongRunningCommand(cmd []string) bool {
+	if len(cmd) == 0 {
+		return false
+	}
+
+	base := filepath.Base(cmd[0])
+	if base != "npm" && base != "npm.cmd" {
+		return false
+	}
+
+	for _, arg := range cmd[1:] {
+		if arg == "dev" || arg == "start" {
+			return true
+		}
+	}
+	return false
+}
+
+func displayHeader() {
+	if version == "" {
+		version = "dev.build"
+	}
+	fmt.Printf("\n%s WebApp Utility v%s\n", CompanyName, version)
+	fmt.Printf("----------------------------------------\n")
+	fmt.Printf("Website: %s\n\n", WebsiteURL)
+}
+
+func ensureWebappsDir() error {
+	fullWebappsPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir)
+	if err := os.MkdirAll(fullWebappsPath, 0755); err != nil {
+		return fmt.Errorf("failed to create webapps directory: %v", err)
+	}
+	return nil
+}
+
+// rootCmd assembles the myapp-cli command tree.
+func rootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "myapp-cli",
+		Short: "Scaffold and manage " + CompanyName + " webapp projects",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// `init` generates the config file, so it must not require one.
+			if cmd.Name() != "init" {
+				loaded, err := loadConfig()
+				if err != nil {
+					return err
+				}
+				cfg = loaded
+			}
+			displayHeader()
+			return nil
+		},
+		SilenceUsage: true,
+	}
+
+	root.AddCommand(newCmd())
+	root.AddCommand(listCmd())
+	root.AddCommand(removeCmd())
+	root.AddCommand(doctorCmd())
+	root.AddCommand(versionCmd())
+	root.AddCommand(initCmd())
+	root.AddCommand(restoreCmd())
+	root.AddCommand(watchCmd())
+	root.AddCommand(replayCmd())
+
+	return root
+}
+
+// Execute runs the root command and returns any error encountered.
+func Execute() error {
+	return rootCmd().Execute()
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	transcriptDirName  = ".myapp-cli"
+	transcriptFileName = "create.log"
+)
+
+// transcriptEntry is one JSON-lines record of a command myapp-cli ran on
+// the user's behalf: enough to diagnose a failed run or replay a
+// successful one.
+type transcriptEntry struct {
+	Time       time.Time `json:"time"`
+	Dir        string    `json:"dir"`
+	Command    []string  `json:"command"`
+	Env        []string  `json:"env,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	ExitCode   int       `json:"exit_code"`
+	Stdout     string    `json:"stdout,omitempty"`
+	Stderr     string    `json:"stderr,omitempty"`
+}
+
+// sensitiveEnvNameParts flags env var names that are redacted before being
+// written to a transcript, since create.log lives on disk under the
+// scaffolded project.
+var sensitiveEnvNameParts = []string{"TOKEN", "SECRET", "PASSWORD", "KEY"}
+
+// redactEnv copies env, replacing the value of any variable whose name
+// looks like it holds a credential with a placeholder.
+func redactEnv(env []string) []string {
+	redacted := make([]string, len(env))
+	for i, kv := range env {
+		name, _, found := strings.Cut(kv, "=")
+		if !found {
+			redacted[i] = kv
+			continue
+		}
+
+		upper := strings.ToUpper(name)
+		sensitive := false
+		for _, part := range sensitiveEnvNameParts {
+			if strings.Contains(upper, part) {
+				sensitive = true
+				break
+			}
+		}
+
+		if sensitive {
+			redacted[i] = name + "=<redacted>"
+		} else {
+			redacted[i] = kv
+		}
+	}
+	return redacted
+}
+
+// Transcript appends JSON-lines command records to a project's
+// .myapp-cli/create.log, so a failed run can be diagnosed and a successful
+// one replayed.
+type Transcript struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// newTranscript opens (creating if necessary) the transcript file for
+// projectPath.
+func newTranscript(projectPath string) (*Transcript, error) {
+	dir := filepath.Join(projectPath, transcriptDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create transcript directory: %w", err)
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, transcriptFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open transcript file: %w", err)
+	}
+
+	return &Transcript{file: file}, nil
+}
+
+func (t *Transcript) record(entry transcriptEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		logger.Warn("failed to encode transcript entry", "error", err)
+		return
+	}
+
+	if _, err := t.file.Write(append(line, '\n')); err != nil {
+		logger.Warn("failed to write transcript entry", "error", err)
+	}
+}
+
+// Close closes the underlying transcript file.
+func (t *Transcript) Close() error {
+	return t.file.Close()
+}
+
+func transcriptPath(projectPath string) string {
+	return filepath.Join(projectPath, transcriptDirName, transcriptFileName)
+}
+
+// readTranscript loads every recorded command for a project, in order.
+func readTranscript(projectPath string) ([]transcriptEntry, error) {
+	data, err := os.ReadFile(transcriptPath(projectPath))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []transcriptEntry
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry transcriptEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse transcript line: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func versionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the myapp-cli version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if version == "" {
+				version = "dev.build"
+			}
+			fmt.Println(version)
+			return nil
+		},
+	}
+}
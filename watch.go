@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/spf13/cobra"
+)
+
+const (
+	watchDebounce = 300 * time.Millisecond
+	watchWorkers  = 4
+)
+
+// watchCmd scaffolds a project (if it doesn't already exist), then mirrors
+// changes from --source into it while streaming `npm run dev` output, until
+// interrupted.
+func watchCmd() *cobra.Command {
+	var sourceDir string
+	var templateName string
+
+	cmd := &cobra.Command{
+		Use:   "watch <project>",
+		Short: "Scaffold a project and mirror --source into it while streaming npm run dev",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if sourceDir == "" {
+				return fmt.Errorf("--source is required")
+			}
+
+			// Resolve to an absolute path before createProject (if it runs)
+			// changes the working directory, so a relative --source still
+			// points at the user's original cwd.
+			absSourceDir, err := filepath.Abs(sourceDir)
+			if err != nil {
+				return fmt.Errorf("failed to resolve --source %q: %w", sourceDir, err)
+			}
+			sourceDir = absSourceDir
+
+			projectName := args[0]
+			projectPath := filepath.Join(cfg.ProjectsDir, cfg.WebappsDir, projectName)
+			cr := NewCommandRunner()
+
+			if !isProjectExists(projectName) {
+				if err := checkPrerequisites(cr); err != nil {
+					return fmt.Errorf("prerequisite check failed: %w", err)
+				}
+				tmpl, err := cfg.Template(templateName)
+				if err != nil {
+					return err
+				}
+				if err := createProject(cr, projectName, tmpl, true); err != nil {
+					return fmt.Errorf("failed to create project: %w", err)
+				}
+			}
+
+			// createProject (if it ran) closes its own transcript once done,
+			// so (re-)attach one here for the dev server we're about to start.
+			if err := cr.attachTranscript(projectPath); err != nil {
+				return err
+			}
+			defer cr.transcript.Close()
+
+			matcher, err := loadIgnoreMatcher(sourceDir)
+			if err != nil {
+				return err
+			}
+
+			watcher, err := fsnotify.NewWatcher()
+			if err != nil {
+				return fmt.Errorf("failed to start file watcher: %w", err)
+			}
+			defer watcher.Close()
+
+			if err := watchTree(watcher, sourceDir, matcher); err != nil {
+				return err
+			}
+
+			devCmd, devDone, err := startDevServer(cr, projectPath)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(sigCh)
+
+			jobs := make(chan fsnotify.Event, 256)
+
+			var wg sync.WaitGroup
+			for i := 0; i < watchWorkers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for event := range jobs {
+						syncEvent(event, sourceDir, projectPath, matcher)
+					}
+				}()
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				debounceLoop(ctx, watcher, jobs, watchDebounce, sourceDir, matcher)
+			}()
+
+			fmt.Printf("[watch] mirroring %s -> %s (ctrl-c to stop)\n", sourceDir, projectPath)
+
+			select {
+			case <-sigCh:
+				fmt.Println("\n[watch] shutting down...")
+			case err := <-devDone:
+				fmt.Printf("[dev] exited: %v\n", err)
+			}
+
+			cancel()
+			wg.Wait()
+
+			if err := killProcessGroup(devCmd); err != nil {
+				fmt.Fprintf(os.Stderr, "[dev] failed to stop dev server: %v\n", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&sourceDir, "source", "", "source directory to mirror into the scaffolded project")
+	cmd.Flags().StringVar(&templateName, "template", "", "template to scaffold from if the project does not already exist")
+
+	return cmd
+}
+
+// loadIgnoreMatcher builds a gitignore matcher from root's .gitignore (and
+// any global/parent patterns go-git's reader picks up).
+func loadIgnoreMatcher(root string) (gitignore.Matcher, error) {
+	billyFS := osfs.New(root)
+	patterns, err := gitignore.ReadPatterns(billyFS, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .gitignore patterns: %w", err)
+	}
+	return gitignore.NewMatcher(patterns), nil
+}
+
+// watchTree registers root and every non-ignored subdirectory with watcher.
+func watchTree(watcher *fsnotify.Watcher, root string, matcher gitignore.Matcher) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return watcher.Add(path)
+		}
+
+		if matcher.Match(strings.Split(filepath.ToSlash(rel), "/"), true) {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(path)
+	})
+}
+
+// watchIfNewDir registers path with watcher if it is a non-ignored
+// directory that was just created under sourceDir, so files subsequently
+// written inside it are still caught by the watcher.
+func watchIfNewDir(watcher *fsnotify.Watcher, sourceDir, path string, matcher gitignore.Matcher) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return
+	}
+
+	rel, err := filepath.Rel(sourceDir, path)
+	if err != nil {
+		return
+	}
+	if matcher.Match(strings.Split(filepath.ToSlash(rel), "/"), true) {
+		return
+	}
+
+	if err := watcher.Add(path); err != nil {
+		fmt.Fprintf(os.Stderr, "[watch] failed to watch new directory %s: %v\n", rel, err)
+	}
+}
+
+// debounceLoop coalesces bursts of fsnotify events per path and forwards
+// one event per path to jobs every debounce interval, until ctx is done. It
+// also registers newly created subdirectories of sourceDir with watcher, so
+// files added to folders created mid-session are still mirrored.
+func debounceLoop(ctx context.Context, watcher *fsnotify.Watcher, jobs chan<- fsnotify.Event, debounce time.Duration, sourceDir string, matcher gitignore.Matcher) {
+	defer close(jobs)
+
+	pending := map[string]fsnotify.Event{}
+	timer := time.NewTimer(debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&fsnotify.Create != 0 {
+				watchIfNewDir(watcher, sourceDir, event.Name, matcher)
+			}
+			pending[event.Name] = event
+			timer.Reset(debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "[watch] error: %v\n", err)
+		case <-timer.C:
+			for _, event := range pending {
+				jobs <- event
+			}
+			pending = map[string]fsnotify.Event{}
+		}
+	}
+}
+
+// syncEvent mirrors a single debounced fsnotify event from sourceDir into
+// destDir, honoring the ignore matcher.
+func syncEvent(event fsnotify.Event, sourceDir, destDir string, matcher gitignore.Matcher) {
+	rel, err := filepath.Rel(sourceDir, event.Name)
+	if err != nil {
+		return
+	}
+
+	info, statErr := os.Stat(event.Name)
+	isDir := statErr == nil && info.IsDir()
+	if matcher.Match(strings.Split(filepath.ToSlash(rel), "/"), isDir) {
+		return
+	}
+
+	destPath := filepath.Join(destDir, rel)
+
+	if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+		if err := os.RemoveAll(destPath); err != nil && !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "[sync] failed to remove %s: %v\n", rel, err)
+		}
+		return
+	}
+
+	if statErr != nil {
+		return
+	}
+	if isDir {
+		if err := os.MkdirAll(destPath, 0755); err != nil {
+			fmt.Fprintf(os.Stderr, "[sync] failed to create %s: %v\n", rel, err)
+		}
+		return
+	}
+
+	if err := copyFile(event.Name, destPath); err != nil {
+		fmt.Fprintf(os.Stderr, "[sync] failed to copy %s: %v\n", rel, err)
+		return
+	}
+
+	fmt.Printf("[sync] %s\n", rel)
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// startDevServer launches `npm run dev` in its own process group, streaming
+// its stdout/stderr to the terminal with a "[dev] " prefix, and records it
+// through cr so a crash during a watch session shows up in the project's
+// transcript just like any other command.
+func startDevServer(cr *CommandRunner, projectPath string) (*exec.Cmd, <-chan error, error) {
+	return cr.startCommand(projectPath, "[dev] ", cr.getNPMCommand(), "run", "dev")
+}
+
+func streamPrefixed(w io.Writer, prefix string, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+	}
+}